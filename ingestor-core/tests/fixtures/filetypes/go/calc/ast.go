@@ -0,0 +1,57 @@
+package calc
+
+// Ops supplies the binary operations the evaluator reuses instead of
+// computing + and - and * itself, so that an AST walk produces the same
+// results a Calculator's own methods would.
+type Ops interface {
+	Add(a, b int) int
+	Subtract(a, b int) int
+	Multiply(a, b int) int
+}
+
+// Expr is a node in a parsed expression tree.
+type Expr interface {
+	eval(ops Ops) (int, error)
+}
+
+// IntLit is an integer literal.
+type IntLit struct {
+	Value int
+}
+
+func (n *IntLit) eval(ops Ops) (int, error) {
+	return n.Value, nil
+}
+
+// BinaryExpr is a left-associative binary operation.
+type BinaryExpr struct {
+	Op          TokenType
+	Left, Right Expr
+}
+
+func (n *BinaryExpr) eval(ops Ops) (int, error) {
+	left, err := n.Left.eval(ops)
+	if err != nil {
+		return 0, err
+	}
+	right, err := n.Right.eval(ops)
+	if err != nil {
+		return 0, err
+	}
+
+	switch n.Op {
+	case TokenPlus:
+		return ops.Add(left, right), nil
+	case TokenMinus:
+		return ops.Subtract(left, right), nil
+	case TokenStar:
+		return ops.Multiply(left, right), nil
+	case TokenSlash:
+		if right == 0 {
+			return 0, &ParseError{Msg: "division by zero"}
+		}
+		return left / right, nil
+	default:
+		return 0, &ParseError{Msg: "unknown operator " + n.Op.String()}
+	}
+}
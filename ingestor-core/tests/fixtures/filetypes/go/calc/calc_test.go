@@ -0,0 +1,132 @@
+package calc
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+type refOps struct{}
+
+func (refOps) Add(a, b int) int      { return a + b }
+func (refOps) Subtract(a, b int) int { return a - b }
+func (refOps) Multiply(a, b int) int { return a * b }
+
+func TestEval(t *testing.T) {
+	tests := []struct {
+		expr string
+		want int
+	}{
+		{"1 + 2", 3},
+		{"2 * 3 + 4", 10},
+		{"2 + 3 * 4", 14},
+		{"(2 + 3) * 4", 20},
+		{"10 - 2 - 3", 5},
+		{"2 * (3 + 4) * 5", 70},
+		{"100 / 5 / 2", 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := Eval(tt.expr, refOps{})
+			if err != nil {
+				t.Fatalf("Eval(%q) returned error: %v", tt.expr, err)
+			}
+			if got != tt.want {
+				t.Fatalf("Eval(%q) = %d, want %d", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvalErrors(t *testing.T) {
+	tests := []string{
+		"1 +",
+		"(1 + 2",
+		"1 + 2)",
+		"1 + * 2",
+		"1 / 0",
+		"",
+		"1 @ 2",
+	}
+
+	for _, expr := range tests {
+		t.Run(expr, func(t *testing.T) {
+			if _, err := Eval(expr, refOps{}); err == nil {
+				t.Fatalf("Eval(%q) = nil error, want error", expr)
+			}
+		})
+	}
+}
+
+// genWellFormed builds a random well-formed expression of the given
+// depth using only small non-negative integers, so the reference
+// evaluator (plain Go arithmetic, with integer division) agrees with
+// Eval's AST walk.
+func genWellFormed(r *rand.Rand, depth int) (string, int) {
+	if depth <= 0 || r.Intn(3) == 0 {
+		n := r.Intn(20) + 1
+		return strconv.Itoa(n), n
+	}
+
+	left, leftVal := genWellFormed(r, depth-1)
+	right, rightVal := genWellFormed(r, depth-1)
+
+	// Parenthesize both operands so the parsed tree always matches the
+	// tree want was computed from, regardless of how +/- and */÷ bind
+	// relative to each other or to an outer operator.
+	left = "(" + left + ")"
+	right = "(" + right + ")"
+
+	switch r.Intn(4) {
+	case 0:
+		return left + " + " + right, leftVal + rightVal
+	case 1:
+		return left + " - " + right, leftVal - rightVal
+	case 2:
+		return left + " * " + right, leftVal * rightVal
+	default:
+		if rightVal == 0 {
+			return left + " + " + right, leftVal + rightVal
+		}
+		return left + " / " + right, leftVal / rightVal
+	}
+}
+
+func FuzzEvalWellFormed(f *testing.F) {
+	f.Add(int64(1))
+	f.Add(int64(42))
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		expr, want := genWellFormed(r, 4)
+
+		got, err := Eval(expr, refOps{})
+		if err != nil {
+			t.Fatalf("Eval(%q) returned error: %v", expr, err)
+		}
+		if got != want {
+			t.Fatalf("Eval(%q) = %d, want %d", expr, got, want)
+		}
+	})
+}
+
+func FuzzEvalMalformed(f *testing.F) {
+	f.Add("1 + ")
+	f.Add("(((1")
+	f.Add("* 2")
+
+	f.Fuzz(func(t *testing.T, expr string) {
+		// Malformed input must never panic; an error is an acceptable and
+		// expected outcome. The only success that is checked is one on
+		// input with no operators or parentheses at all, which must then
+		// be a plain integer.
+		_, err := Eval(expr, refOps{})
+		if err == nil && strings.ContainsAny(expr, "+-*/()") == false && expr != "" {
+			if _, convErr := strconv.Atoi(strings.TrimSpace(expr)); convErr != nil {
+				t.Fatalf("Eval(%q) unexpectedly succeeded", expr)
+			}
+		}
+	})
+}
@@ -0,0 +1,108 @@
+// Package calc implements a small recursive-descent parser and evaluator
+// for arithmetic expressions, in the grammar-switch style of the
+// tutorialspoint Go compiler-design examples: expression -> term ->
+// factor, with a single token of lookahead driving each match.
+package calc
+
+import (
+	"fmt"
+	"unicode"
+)
+
+// TokenType classifies a lexical token.
+type TokenType int
+
+// Token types produced by the lexer.
+const (
+	TokenEOF TokenType = iota
+	TokenInt
+	TokenIdent
+	TokenPlus
+	TokenMinus
+	TokenStar
+	TokenSlash
+	TokenLParen
+	TokenRParen
+)
+
+func (t TokenType) String() string {
+	switch t {
+	case TokenEOF:
+		return "EOF"
+	case TokenInt:
+		return "int"
+	case TokenIdent:
+		return "identifier"
+	case TokenPlus:
+		return "'+'"
+	case TokenMinus:
+		return "'-'"
+	case TokenStar:
+		return "'*'"
+	case TokenSlash:
+		return "'/'"
+	case TokenLParen:
+		return "'('"
+	case TokenRParen:
+		return "')'"
+	default:
+		return "unknown"
+	}
+}
+
+// Token is a single lexical token together with its source position.
+type Token struct {
+	Type  TokenType
+	Value string
+	Pos   int
+}
+
+// Lex tokenizes expr, returning the tokens in order with a trailing
+// TokenEOF, or an error if it contains a character no token recognizes.
+func Lex(expr string) ([]Token, error) {
+	var tokens []Token
+	runes := []rune(expr)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r):
+			start := i
+			for i < len(runes) && unicode.IsDigit(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, Token{Type: TokenInt, Value: string(runes[start:i]), Pos: start})
+		case unicode.IsLetter(r):
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i])) {
+				i++
+			}
+			tokens = append(tokens, Token{Type: TokenIdent, Value: string(runes[start:i]), Pos: start})
+		case r == '+':
+			tokens = append(tokens, Token{Type: TokenPlus, Value: "+", Pos: i})
+			i++
+		case r == '-':
+			tokens = append(tokens, Token{Type: TokenMinus, Value: "-", Pos: i})
+			i++
+		case r == '*':
+			tokens = append(tokens, Token{Type: TokenStar, Value: "*", Pos: i})
+			i++
+		case r == '/':
+			tokens = append(tokens, Token{Type: TokenSlash, Value: "/", Pos: i})
+			i++
+		case r == '(':
+			tokens = append(tokens, Token{Type: TokenLParen, Value: "(", Pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, Token{Type: TokenRParen, Value: ")", Pos: i})
+			i++
+		default:
+			return nil, fmt.Errorf("calc: unexpected character %q at position %d", r, i)
+		}
+	}
+
+	tokens = append(tokens, Token{Type: TokenEOF, Pos: len(runes)})
+	return tokens, nil
+}
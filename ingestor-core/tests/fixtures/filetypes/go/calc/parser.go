@@ -0,0 +1,147 @@
+package calc
+
+import "fmt"
+
+// ParseError reports a token mismatch encountered while parsing, or a
+// later evaluation failure (e.g. division by zero) that has no single
+// token to point at.
+type ParseError struct {
+	Pos      int
+	Expected TokenType
+	Got      Token
+	Msg      string
+}
+
+func (e *ParseError) Error() string {
+	if e.Msg != "" {
+		return fmt.Sprintf("calc: %s", e.Msg)
+	}
+	return fmt.Sprintf("calc: at position %d, expected %s but got %s %q", e.Pos, e.Expected, e.Got.Type, e.Got.Value)
+}
+
+// Parser is a recursive-descent parser over a fixed token stream, with a
+// single token of lookahead.
+type Parser struct {
+	tokens    []Token
+	pos       int
+	lookahead Token
+}
+
+// NewParser returns a Parser positioned at the first token.
+func NewParser(tokens []Token) *Parser {
+	p := &Parser{tokens: tokens}
+	p.lookahead = p.tokens[0]
+	return p
+}
+
+func (p *Parser) advance() {
+	p.pos++
+	if p.pos < len(p.tokens) {
+		p.lookahead = p.tokens[p.pos]
+	}
+}
+
+// match consumes the lookahead token if it has type tt, or returns a
+// *ParseError naming what was expected instead.
+func (p *Parser) match(tt TokenType) (Token, error) {
+	if p.lookahead.Type != tt {
+		return Token{}, &ParseError{Pos: p.lookahead.Pos, Expected: tt, Got: p.lookahead}
+	}
+	tok := p.lookahead
+	p.advance()
+	return tok, nil
+}
+
+// Parse parses a complete expression, and fails if input remains after
+// it.
+func (p *Parser) Parse() (Expr, error) {
+	expr, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.match(TokenEOF); err != nil {
+		return nil, err
+	}
+	return expr, nil
+}
+
+// expression := term (('+' | '-') term)*
+func (p *Parser) expression() (Expr, error) {
+	left, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.lookahead.Type == TokenPlus || p.lookahead.Type == TokenMinus {
+		op := p.lookahead.Type
+		p.advance()
+		right, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		left = &BinaryExpr{Op: op, Left: left, Right: right}
+	}
+	return left, nil
+}
+
+// term := factor (('*' | '/') factor)*
+func (p *Parser) term() (Expr, error) {
+	left, err := p.factor()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.lookahead.Type == TokenStar || p.lookahead.Type == TokenSlash {
+		op := p.lookahead.Type
+		p.advance()
+		right, err := p.factor()
+		if err != nil {
+			return nil, err
+		}
+		left = &BinaryExpr{Op: op, Left: left, Right: right}
+	}
+	return left, nil
+}
+
+// factor := int | '(' expression ')'
+func (p *Parser) factor() (Expr, error) {
+	if p.lookahead.Type == TokenLParen {
+		p.advance()
+		expr, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.match(TokenRParen); err != nil {
+			return nil, err
+		}
+		return expr, nil
+	}
+
+	tok, err := p.match(TokenInt)
+	if err != nil {
+		return nil, err
+	}
+
+	value := 0
+	for _, r := range tok.Value {
+		value = value*10 + int(r-'0')
+	}
+	return &IntLit{Value: value}, nil
+}
+
+// Eval lexes, parses, and evaluates expr, reusing ops for each binary
+// + and - and * operation so the result matches what calling ops
+// directly would produce.
+func Eval(expr string, ops Ops) (int, error) {
+	tokens, err := Lex(expr)
+	if err != nil {
+		return 0, err
+	}
+
+	tree, err := NewParser(tokens).Parse()
+	if err != nil {
+		return 0, err
+	}
+
+	return tree.eval(ops)
+}
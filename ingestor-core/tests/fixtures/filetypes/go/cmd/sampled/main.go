@@ -0,0 +1,41 @@
+// Command sampled boots an RPC server exposing sample.UserService and
+// sample.Calculator, over either the gob or JSON net/rpc codec selected
+// with -transport.
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+
+	"sample"
+	"sample/rpc"
+)
+
+func main() {
+	addr := flag.String("addr", ":8090", "listen address")
+	transportName := flag.String("transport", "gob", "rpc transport: gob or json")
+	flag.Parse()
+
+	var transport rpc.Transport
+	switch *transportName {
+	case "gob":
+		transport = rpc.TransportGob
+	case "json":
+		transport = rpc.TransportJSON
+	default:
+		log.Fatalf("unknown transport %q (want gob or json)", *transportName)
+	}
+
+	server, err := rpc.NewServer(transport, rpc.NewUserServiceHandler(sample.NewUserService()), rpc.NewCalculatorHandler(sample.NewCalculator()))
+	if err != nil {
+		log.Fatalf("new server: %v", err)
+	}
+
+	l, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatalf("listen on %s: %v", *addr, err)
+	}
+	log.Printf("sampled: serving %s transport on %s", *transportName, *addr)
+	log.Fatal(server.Serve(l))
+}
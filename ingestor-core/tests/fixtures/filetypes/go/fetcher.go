@@ -0,0 +1,203 @@
+package sample
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Response is the result of a successful Fetcher request.
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// Fetcher performs context-aware HTTP GETs with exponential backoff and
+// jitter between retries.
+type Fetcher struct {
+	client         *http.Client
+	maxRetries     int
+	baseDelay      time.Duration
+	maxDelay       time.Duration
+	attemptTimeout time.Duration
+	userAgent      string
+}
+
+// Option configures a Fetcher built by NewFetcher.
+type Option func(*Fetcher)
+
+// WithClient overrides the http.Client used for requests.
+func WithClient(client *http.Client) Option {
+	return func(f *Fetcher) { f.client = client }
+}
+
+// WithRetry sets the maximum number of retries after the first attempt.
+func WithRetry(maxRetries int) Option {
+	return func(f *Fetcher) { f.maxRetries = maxRetries }
+}
+
+// WithBackoff sets the base and max delay for exponential backoff
+// between retries.
+func WithBackoff(base, max time.Duration) Option {
+	return func(f *Fetcher) {
+		f.baseDelay = base
+		f.maxDelay = max
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(f *Fetcher) { f.userAgent = userAgent }
+}
+
+// WithAttemptTimeout bounds how long a single attempt may run before it
+// is abandoned in favor of the next retry, independent of how much of
+// the parent ctx's budget remains. A timeout of 0 disables this and lets
+// an attempt run for as long as the parent ctx allows.
+func WithAttemptTimeout(timeout time.Duration) Option {
+	return func(f *Fetcher) { f.attemptTimeout = timeout }
+}
+
+// NewFetcher builds a Fetcher with sane defaults, overridden by opts.
+func NewFetcher(opts ...Option) *Fetcher {
+	f := &Fetcher{
+		client:         http.DefaultClient,
+		maxRetries:     3,
+		baseDelay:      100 * time.Millisecond,
+		maxDelay:       5 * time.Second,
+		attemptTimeout: 30 * time.Second,
+		userAgent:      "sample-fetcher/1.0",
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
+}
+
+// defaultFetcher backs the package-level FetchData for callers that
+// don't need a configured Fetcher of their own.
+var defaultFetcher = NewFetcher()
+
+// Fetch GETs url, retrying on transient failures and honoring
+// Retry-After on 429 and 503 responses, until ctx is done or the retry
+// budget is exhausted.
+func (f *Fetcher) Fetch(ctx context.Context, url string) (*Response, error) {
+	var lastErr error
+
+	for attempt := 0; attempt <= f.maxRetries; attempt++ {
+		if attempt > 0 {
+			delay := f.retryDelay(attempt, lastErr)
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(delay):
+			}
+		}
+
+		resp, err := f.attempt(ctx, url)
+		if err == nil {
+			return resp, nil
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		lastErr = err
+	}
+
+	return nil, lastErr
+}
+
+// attempt performs a single GET, returning a *retryAfterError for
+// retryable status codes so Fetch can back off accordingly. It runs
+// under a deadline derived from ctx and bounded by attemptTimeout, so a
+// single hung attempt can't consume the entire retry budget.
+func (f *Fetcher) attempt(ctx context.Context, url string) (*Response, error) {
+	if f.attemptTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, f.attemptTimeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", f.userAgent)
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		retryAfter, hasRetryAfter := parseRetryAfter(resp.Header.Get("Retry-After"))
+		return nil, &retryAfterError{statusCode: resp.StatusCode, retryAfter: retryAfter, hasRetryAfter: hasRetryAfter}
+	}
+
+	return &Response{StatusCode: resp.StatusCode, Header: resp.Header, Body: body}, nil
+}
+
+// retryDelay computes how long to wait before the given attempt,
+// honoring a server-specified Retry-After if lastErr carries one and
+// otherwise applying exponential backoff with jitter.
+func (f *Fetcher) retryDelay(attempt int, lastErr error) time.Duration {
+	if rae, ok := lastErr.(*retryAfterError); ok && rae.hasRetryAfter {
+		return rae.retryAfter
+	}
+
+	delay := f.baseDelay * time.Duration(1<<uint(attempt-1))
+	if delay > f.maxDelay {
+		delay = f.maxDelay
+	}
+	jitter := time.Duration(rand.Int63n(int64(delay)/2 + 1))
+	return delay/2 + jitter
+}
+
+// retryAfterError signals a 429 or 503 response, optionally carrying the
+// delay the server asked for via Retry-After. hasRetryAfter distinguishes
+// a header absent entirely from a header set to "0" (retry immediately),
+// since both parse to a zero retryAfter duration.
+type retryAfterError struct {
+	statusCode    int
+	retryAfter    time.Duration
+	hasRetryAfter bool
+}
+
+func (e *retryAfterError) Error() string {
+	return "sample: received status " + strconv.Itoa(e.statusCode)
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds, returning
+// ok=false if it is absent or not a plain non-negative integer.
+func parseRetryAfter(value string) (delay time.Duration, ok bool) {
+	if value == "" {
+		return 0, false
+	}
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
+// FetchData fetches url using a shared default Fetcher, returning its
+// body as a string. It exists for backwards compatibility with callers
+// that don't need retry or transport configuration; new code should
+// prefer NewFetcher.
+func FetchData(ctx context.Context, url string) (string, error) {
+	resp, err := defaultFetcher.Fetch(ctx, url)
+	if err != nil {
+		return "", err
+	}
+	return string(resp.Body), nil
+}
@@ -0,0 +1,133 @@
+package sample
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFetcherRetriesUntilSuccess(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	f := NewFetcher(WithRetry(5), WithBackoff(time.Millisecond, 10*time.Millisecond))
+	resp, err := f.Fetch(context.Background(), server.URL)
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	if string(resp.Body) != "ok" {
+		t.Fatalf("Body = %q, want %q", resp.Body, "ok")
+	}
+	if attempts != 3 {
+		t.Fatalf("attempts = %d, want 3", attempts)
+	}
+}
+
+func TestFetcherHonorsRetryAfter(t *testing.T) {
+	var attempts int32
+	var firstAttempt time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			firstAttempt = time.Now()
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	f := NewFetcher(WithRetry(2), WithBackoff(time.Second, time.Second))
+	resp, err := f.Fetch(context.Background(), server.URL)
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	if string(resp.Body) != "ok" {
+		t.Fatalf("Body = %q, want %q", resp.Body, "ok")
+	}
+	if time.Since(firstAttempt) > 500*time.Millisecond {
+		t.Fatalf("retry took too long, Retry-After: 0 was not honored")
+	}
+}
+
+func TestFetcherExhaustsRetries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	f := NewFetcher(WithRetry(2), WithBackoff(time.Millisecond, 5*time.Millisecond))
+	if _, err := f.Fetch(context.Background(), server.URL); err == nil {
+		t.Fatal("Fetch: expected error after exhausting retries, got nil")
+	}
+}
+
+func TestFetcherAbortsOnCanceledContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	f := NewFetcher(WithRetry(5), WithBackoff(time.Millisecond, 5*time.Millisecond))
+	if _, err := f.Fetch(ctx, server.URL); err != context.Canceled {
+		t.Fatalf("Fetch: err = %v, want context.Canceled", err)
+	}
+}
+
+func TestFetcherAttemptTimeoutMovesOnToNextRetry(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			time.Sleep(200 * time.Millisecond) // hangs past attemptTimeout below
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	f := NewFetcher(
+		WithRetry(3),
+		WithBackoff(time.Millisecond, 5*time.Millisecond),
+		WithAttemptTimeout(20*time.Millisecond),
+	)
+
+	start := time.Now()
+	resp, err := f.Fetch(context.Background(), server.URL)
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	if string(resp.Body) != "ok" {
+		t.Fatalf("Body = %q, want %q", resp.Body, "ok")
+	}
+	if elapsed := time.Since(start); elapsed > 150*time.Millisecond {
+		t.Fatalf("Fetch took %v, want it to abandon the hung first attempt well before its 200ms sleep finishes", elapsed)
+	}
+}
+
+func TestFetchDataBackwardsCompatible(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("Data from " + r.URL.String()))
+	}))
+	defer server.Close()
+
+	got, err := FetchData(context.Background(), server.URL)
+	if err != nil {
+		t.Fatalf("FetchData: %v", err)
+	}
+	if got == "" {
+		t.Fatal("FetchData returned empty body")
+	}
+}
@@ -0,0 +1,121 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+
+	"sample"
+)
+
+// Client calls a UserService/Calculator Server over a net/rpc connection.
+type Client struct {
+	rpcClient *rpc.Client
+}
+
+// Dial connects to conn using transport and returns a Client.
+func Dial(transport Transport, conn net.Conn) *Client {
+	var c *rpc.Client
+	if transport == TransportJSON {
+		c = rpc.NewClientWithCodec(jsonrpc.NewClientCodec(conn))
+	} else {
+		c = rpc.NewClient(conn)
+	}
+	return &Client{rpcClient: c}
+}
+
+// Close closes the underlying connection.
+func (c *Client) Close() error {
+	return c.rpcClient.Close()
+}
+
+// AddUser calls UserService.AddUser. net/rpc flattens any handler error
+// to a string-only rpc.ServerError, so a *UserAlreadyExists returned by
+// the handler is decoded back from that string on the way out.
+func (c *Client) AddUser(ctx context.Context, user *sample.User) error {
+	err := c.rpcClient.Call("UserService.RPCAddUser", &AddUserRequest{User: user}, &AddUserResponse{})
+	return decodeUserAlreadyExists(err)
+}
+
+// GetUser calls UserService.GetUser.
+func (c *Client) GetUser(ctx context.Context, id int) (*sample.User, bool, error) {
+	resp := &GetUserResponse{}
+	if err := c.rpcClient.Call("UserService.RPCGetUser", &GetUserRequest{ID: id}, resp); err != nil {
+		return nil, false, err
+	}
+	return resp.User, resp.Found, nil
+}
+
+// ListUsers calls UserService.ListUsers.
+func (c *Client) ListUsers(ctx context.Context) ([]*sample.User, error) {
+	resp := &ListUsersResponse{}
+	if err := c.rpcClient.Call("UserService.RPCListUsers", &ListUsersRequest{}, resp); err != nil {
+		return nil, err
+	}
+	return resp.Users, nil
+}
+
+// Add calls Calculator.Add.
+func (c *Client) Add(ctx context.Context, a, b int) (int, error) {
+	resp := &AddResponse{}
+	if err := c.rpcClient.Call("Calculator.RPCAdd", &AddRequest{A: a, B: b}, resp); err != nil {
+		return 0, err
+	}
+	return resp.Result, nil
+}
+
+// Subtract calls Calculator.Subtract.
+func (c *Client) Subtract(ctx context.Context, a, b int) (int, error) {
+	resp := &AddResponse{}
+	if err := c.rpcClient.Call("Calculator.RPCSubtract", &AddRequest{A: a, B: b}, resp); err != nil {
+		return 0, err
+	}
+	return resp.Result, nil
+}
+
+// Multiply calls Calculator.Multiply.
+func (c *Client) Multiply(ctx context.Context, a, b int) (int, error) {
+	resp := &AddResponse{}
+	if err := c.rpcClient.Call("Calculator.RPCMultiply", &AddRequest{A: a, B: b}, resp); err != nil {
+		return 0, err
+	}
+	return resp.Result, nil
+}
+
+// InMemoryClient calls the handlers directly, with no network or
+// serialization involved. Tests use it to exercise request/response
+// plumbing without standing up a listener.
+type InMemoryClient struct {
+	users *UserServiceHandler
+	calc  *CalculatorHandler
+}
+
+// NewInMemoryClient builds an InMemoryClient around handler and calc.
+func NewInMemoryClient(handler *UserServiceHandler, calc *CalculatorHandler) *InMemoryClient {
+	return &InMemoryClient{users: handler, calc: calc}
+}
+
+// AddUser calls UserServiceHandler.AddUser directly.
+func (c *InMemoryClient) AddUser(ctx context.Context, user *sample.User) error {
+	_, err := c.users.AddUser(ctx, &AddUserRequest{User: user})
+	return err
+}
+
+// GetUser calls UserServiceHandler.GetUser directly.
+func (c *InMemoryClient) GetUser(ctx context.Context, id int) (*sample.User, bool, error) {
+	resp, err := c.users.GetUser(ctx, &GetUserRequest{ID: id})
+	if err != nil {
+		return nil, false, err
+	}
+	return resp.User, resp.Found, nil
+}
+
+// ListUsers calls UserServiceHandler.ListUsers directly.
+func (c *InMemoryClient) ListUsers(ctx context.Context) ([]*sample.User, error) {
+	resp, err := c.users.ListUsers(ctx, &ListUsersRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Users, nil
+}
@@ -0,0 +1,135 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"sample"
+)
+
+// UserServiceHandler adapts a *sample.UserService to a context-aware,
+// wire-friendly API. Its RPC-prefixed methods match the func(args, *reply)
+// error shape net/rpc expects, so a handler can be registered directly
+// with rpc.Server; the ctx-aware methods underneath are what callers and
+// the in-memory client actually use.
+type UserServiceHandler struct {
+	svc *sample.UserService
+}
+
+// NewUserServiceHandler wraps svc for RPC exposure.
+func NewUserServiceHandler(svc *sample.UserService) *UserServiceHandler {
+	return &UserServiceHandler{svc: svc}
+}
+
+// AddUser registers req.User, returning *UserAlreadyExists if the ID is
+// already taken and propagating any other failure (a canceled ctx, a
+// store I/O error) unchanged.
+func (h *UserServiceHandler) AddUser(ctx context.Context, req *AddUserRequest) (*AddUserResponse, error) {
+	err := h.svc.AddUser(ctx, req.User)
+	if err == nil {
+		return &AddUserResponse{}, nil
+	}
+
+	var exists *sample.AlreadyExistsError
+	if errors.As(err, &exists) {
+		return nil, &UserAlreadyExists{ID: exists.ID}
+	}
+	return nil, err
+}
+
+// GetUser looks up a user by ID.
+func (h *UserServiceHandler) GetUser(ctx context.Context, req *GetUserRequest) (*GetUserResponse, error) {
+	user, ok, err := h.svc.GetUser(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &GetUserResponse{User: user, Found: ok}, nil
+}
+
+// ListUsers returns every registered user.
+func (h *UserServiceHandler) ListUsers(ctx context.Context, req *ListUsersRequest) (*ListUsersResponse, error) {
+	users, err := h.svc.ListUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ListUsersResponse{Users: users}, nil
+}
+
+// RPCAddUser is the net/rpc-shaped entry point registered with an
+// *rpc.Server; it runs the ctx-aware handler with a background context
+// since net/rpc does not propagate one over the wire.
+func (h *UserServiceHandler) RPCAddUser(req *AddUserRequest, resp *AddUserResponse) error {
+	out, err := h.AddUser(context.Background(), req)
+	if err != nil {
+		return err
+	}
+	*resp = *out
+	return nil
+}
+
+// RPCGetUser is the net/rpc-shaped entry point for GetUser.
+func (h *UserServiceHandler) RPCGetUser(req *GetUserRequest, resp *GetUserResponse) error {
+	out, err := h.GetUser(context.Background(), req)
+	if err != nil {
+		return err
+	}
+	*resp = *out
+	return nil
+}
+
+// RPCListUsers is the net/rpc-shaped entry point for ListUsers.
+func (h *UserServiceHandler) RPCListUsers(req *ListUsersRequest, resp *ListUsersResponse) error {
+	out, err := h.ListUsers(context.Background(), req)
+	if err != nil {
+		return err
+	}
+	*resp = *out
+	return nil
+}
+
+// CalculatorHandler adapts a sample.Calculator the same way
+// UserServiceHandler adapts a *sample.UserService.
+type CalculatorHandler struct {
+	calc sample.Calculator
+}
+
+// NewCalculatorHandler wraps calc for RPC exposure.
+func NewCalculatorHandler(calc sample.Calculator) *CalculatorHandler {
+	return &CalculatorHandler{calc: calc}
+}
+
+// Add is the context-aware wrapper around Calculator.Add.
+func (h *CalculatorHandler) Add(ctx context.Context, req *AddRequest) (*AddResponse, error) {
+	return &AddResponse{Result: h.calc.Add(req.A, req.B)}, nil
+}
+
+// Subtract is the context-aware wrapper around Calculator.Subtract.
+func (h *CalculatorHandler) Subtract(ctx context.Context, req *AddRequest) (*AddResponse, error) {
+	return &AddResponse{Result: h.calc.Subtract(req.A, req.B)}, nil
+}
+
+// Multiply is the context-aware wrapper around Calculator.Multiply.
+func (h *CalculatorHandler) Multiply(ctx context.Context, req *AddRequest) (*AddResponse, error) {
+	return &AddResponse{Result: h.calc.Multiply(req.A, req.B)}, nil
+}
+
+// RPCAdd is the net/rpc-shaped entry point for Add.
+func (h *CalculatorHandler) RPCAdd(req *AddRequest, resp *AddResponse) error {
+	out, _ := h.Add(context.Background(), req)
+	*resp = *out
+	return nil
+}
+
+// RPCSubtract is the net/rpc-shaped entry point for Subtract.
+func (h *CalculatorHandler) RPCSubtract(req *AddRequest, resp *AddResponse) error {
+	out, _ := h.Subtract(context.Background(), req)
+	*resp = *out
+	return nil
+}
+
+// RPCMultiply is the net/rpc-shaped entry point for Multiply.
+func (h *CalculatorHandler) RPCMultiply(req *AddRequest, resp *AddResponse) error {
+	out, _ := h.Multiply(context.Background(), req)
+	*resp = *out
+	return nil
+}
@@ -0,0 +1,92 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"sample"
+)
+
+func TestUserServiceOverPipe(t *testing.T) {
+	for _, transport := range []Transport{TransportGob, TransportJSON} {
+		server, err := NewServer(transport, NewUserServiceHandler(sample.NewUserService()), NewCalculatorHandler(sample.NewCalculator()))
+		if err != nil {
+			t.Fatalf("NewServer: %v", err)
+		}
+
+		serverConn, clientConn := net.Pipe()
+		go server.ServeConn(serverConn)
+
+		client := Dial(transport, clientConn)
+		defer client.Close()
+
+		ctx := context.Background()
+		if err := client.AddUser(ctx, &sample.User{ID: 1, Name: "Ada"}); err != nil {
+			t.Fatalf("AddUser: %v", err)
+		}
+
+		err = client.AddUser(ctx, &sample.User{ID: 1, Name: "Ada"})
+		var alreadyExists *UserAlreadyExists
+		if !errors.As(err, &alreadyExists) {
+			t.Fatalf("AddUser: err = %v, want a *UserAlreadyExists decoded over the wire", err)
+		}
+		if alreadyExists.ID != 1 {
+			t.Fatalf("AddUser: UserAlreadyExists.ID = %d, want 1", alreadyExists.ID)
+		}
+
+		user, ok, err := client.GetUser(ctx, 1)
+		if err != nil || !ok || user.Name != "Ada" {
+			t.Fatalf("GetUser = %+v, %v, %v", user, ok, err)
+		}
+
+		users, err := client.ListUsers(ctx)
+		if err != nil || len(users) != 1 {
+			t.Fatalf("ListUsers = %+v, %v", users, err)
+		}
+
+		sum, err := client.Add(ctx, 2, 3)
+		if err != nil || sum != 5 {
+			t.Fatalf("Add = %d, %v", sum, err)
+		}
+	}
+}
+
+func TestInMemoryClient(t *testing.T) {
+	handler := NewUserServiceHandler(sample.NewUserService())
+	calc := NewCalculatorHandler(sample.NewCalculator())
+	client := NewInMemoryClient(handler, calc)
+
+	ctx := context.Background()
+	if err := client.AddUser(ctx, &sample.User{ID: 7, Name: "Grace"}); err != nil {
+		t.Fatalf("AddUser: %v", err)
+	}
+
+	user, ok, err := client.GetUser(ctx, 7)
+	if err != nil || !ok || user.Name != "Grace" {
+		t.Fatalf("GetUser = %+v, %v, %v", user, ok, err)
+	}
+}
+
+func TestInMemoryClientAddUserCanceledContext(t *testing.T) {
+	handler := NewUserServiceHandler(sample.NewUserService())
+	calc := NewCalculatorHandler(sample.NewCalculator())
+	client := NewInMemoryClient(handler, calc)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := client.AddUser(ctx, &sample.User{ID: 1, Name: "Ada"})
+	var alreadyExists *UserAlreadyExists
+	if errors.As(err, &alreadyExists) {
+		t.Fatalf("AddUser with a canceled context was misreported as %v, want context.Canceled", err)
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("AddUser: err = %v, want context.Canceled", err)
+	}
+
+	if _, ok, _ := client.GetUser(context.Background(), 1); ok {
+		t.Fatal("GetUser: user 1 exists, but AddUser should have failed before it was added")
+	}
+}
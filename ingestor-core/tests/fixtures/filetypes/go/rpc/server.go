@@ -0,0 +1,60 @@
+package rpc
+
+import (
+	"fmt"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+)
+
+// Transport selects the wire codec a Server uses.
+type Transport int
+
+const (
+	// TransportGob serves with net/rpc's default gob codec.
+	TransportGob Transport = iota
+	// TransportJSON serves with net/rpc/jsonrpc's JSON codec.
+	TransportJSON
+)
+
+// Server exposes a UserServiceHandler and CalculatorHandler over a chosen
+// Transport.
+type Server struct {
+	rpcServer *rpc.Server
+	transport Transport
+}
+
+// NewServer registers handler and calc for RPC dispatch over transport.
+func NewServer(transport Transport, handler *UserServiceHandler, calc *CalculatorHandler) (*Server, error) {
+	s := rpc.NewServer()
+	if err := s.RegisterName("UserService", handler); err != nil {
+		return nil, fmt.Errorf("register UserService: %w", err)
+	}
+	if err := s.RegisterName("Calculator", calc); err != nil {
+		return nil, fmt.Errorf("register Calculator: %w", err)
+	}
+	return &Server{rpcServer: s, transport: transport}, nil
+}
+
+// ServeConn serves a single connection, blocking until it closes. Tests
+// use this directly with one end of a net.Pipe.
+func (s *Server) ServeConn(conn net.Conn) {
+	switch s.transport {
+	case TransportJSON:
+		s.rpcServer.ServeCodec(jsonrpc.NewServerCodec(conn))
+	default:
+		s.rpcServer.ServeConn(conn)
+	}
+}
+
+// Serve accepts connections on l until it is closed, serving each with
+// ServeConn.
+func (s *Server) Serve(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go s.ServeConn(conn)
+	}
+}
@@ -0,0 +1,93 @@
+// Package rpc exposes sample.UserService and sample.Calculator over an
+// RPC transport: a thin, context-aware handler layer in front of the
+// existing business logic, reachable over net/rpc (gob) or
+// net/rpc/jsonrpc depending on how the server is started.
+package rpc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"sample"
+)
+
+// userAlreadyExistsPrefix marks the wire encoding of a *UserAlreadyExists
+// error. net/rpc has no typed-error channel of its own: any error a
+// registered method returns crosses the wire as a plain string wrapped
+// in rpc.ServerError, so a client has to recognize this prefix and
+// decode the ID back out of it (see decodeUserAlreadyExists).
+const userAlreadyExistsPrefix = "user-already-exists:"
+
+// UserAlreadyExists is returned by the context-aware AddUser wrapper when
+// a user with the same ID has already been registered.
+type UserAlreadyExists struct {
+	ID int
+}
+
+func (e *UserAlreadyExists) Error() string {
+	return fmt.Sprintf("%s%d", userAlreadyExistsPrefix, e.ID)
+}
+
+// decodeUserAlreadyExists recovers a *UserAlreadyExists from err if it
+// carries one, whether err is already that type (as UserServiceHandler
+// returns it) or an *rpc.ServerError whose message was encoded by
+// UserAlreadyExists.Error (the networked Client path). Any other error
+// is returned unchanged.
+func decodeUserAlreadyExists(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(*UserAlreadyExists); ok {
+		return err
+	}
+
+	msg := err.Error()
+	idStr, found := strings.CutPrefix(msg, userAlreadyExistsPrefix)
+	if !found {
+		return err
+	}
+	id, convErr := strconv.Atoi(idStr)
+	if convErr != nil {
+		return err
+	}
+	return &UserAlreadyExists{ID: id}
+}
+
+// AddUserRequest is the argument to UserServiceHandler.AddUser.
+type AddUserRequest struct {
+	User *sample.User
+}
+
+// AddUserResponse is the result of UserServiceHandler.AddUser.
+type AddUserResponse struct{}
+
+// GetUserRequest is the argument to UserServiceHandler.GetUser.
+type GetUserRequest struct {
+	ID int
+}
+
+// GetUserResponse is the result of UserServiceHandler.GetUser.
+type GetUserResponse struct {
+	User  *sample.User
+	Found bool
+}
+
+// ListUsersRequest is the argument to UserServiceHandler.ListUsers.
+type ListUsersRequest struct{}
+
+// ListUsersResponse is the result of UserServiceHandler.ListUsers.
+type ListUsersResponse struct {
+	Users []*sample.User
+}
+
+// AddRequest is the argument to CalculatorHandler.Add and Subtract and
+// Multiply.
+type AddRequest struct {
+	A, B int
+}
+
+// AddResponse is the result of a CalculatorHandler binary operation.
+type AddResponse struct {
+	Result int
+}
@@ -3,8 +3,11 @@ package sample
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"sync"
+
+	"sample/calc"
+	"sample/store"
 )
 
 // User represents a user in the system.
@@ -14,61 +17,67 @@ type User struct {
 	Email string
 }
 
-// UserService manages user operations.
+// AlreadyExistsError is returned by AddUser when a user with the same ID
+// has already been registered, so callers can detect that specific case
+// regardless of which UserStore backs the service.
+type AlreadyExistsError struct {
+	ID int
+}
+
+func (e *AlreadyExistsError) Error() string {
+	return fmt.Sprintf("user %d already exists", e.ID)
+}
+
+// UserService manages user operations. It holds no storage of its own,
+// delegating persistence to a store.UserStore so the backend can be
+// swapped without touching this business logic.
 type UserService struct {
-	mu    sync.RWMutex
-	users map[int]*User
+	store store.UserStore
 }
 
-// NewUserService creates a new UserService.
+// NewUserService creates a new UserService backed by an in-memory store.
 func NewUserService() *UserService {
-	return &UserService{
-		users: make(map[int]*User),
-	}
+	return NewUserServiceWithStore(store.NewInMemoryStore())
+}
+
+// NewUserServiceWithStore creates a new UserService backed by s, for
+// callers that want BoltDB, SQL, or another UserStore implementation.
+func NewUserServiceWithStore(s store.UserStore) *UserService {
+	return &UserService{store: s}
 }
 
 // AddUser adds a user to the service.
-func (s *UserService) AddUser(user *User) error {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+func (s *UserService) AddUser(ctx context.Context, user *User) error {
+	err := s.store.Add(ctx, &store.User{ID: user.ID, Name: user.Name, Email: user.Email})
 
-	if _, exists := s.users[user.ID]; exists {
-		return fmt.Errorf("user %d already exists", user.ID)
+	var exists *store.AlreadyExistsError
+	if errors.As(err, &exists) {
+		return &AlreadyExistsError{ID: exists.ID}
 	}
-
-	s.users[user.ID] = user
-	return nil
+	return err
 }
 
 // GetUser retrieves a user by ID.
-func (s *UserService) GetUser(id int) (*User, bool) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-
-	user, ok := s.users[id]
-	return user, ok
+func (s *UserService) GetUser(ctx context.Context, id int) (*User, bool, error) {
+	rec, ok, err := s.store.Get(ctx, id)
+	if err != nil || !ok {
+		return nil, ok, err
+	}
+	return &User{ID: rec.ID, Name: rec.Name, Email: rec.Email}, true, nil
 }
 
 // ListUsers returns all users.
-func (s *UserService) ListUsers() []*User {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-
-	users := make([]*User, 0, len(s.users))
-	for _, user := range s.users {
-		users = append(users, user)
+func (s *UserService) ListUsers(ctx context.Context) ([]*User, error) {
+	recs, err := s.store.List(ctx, 0, 0)
+	if err != nil {
+		return nil, err
 	}
-	return users
-}
 
-// FetchData simulates an async data fetch operation.
-func FetchData(ctx context.Context, url string) (string, error) {
-	select {
-	case <-ctx.Done():
-		return "", ctx.Err()
-	default:
-		return fmt.Sprintf("Data from %s", url), nil
+	users := make([]*User, 0, len(recs))
+	for _, rec := range recs {
+		users = append(users, &User{ID: rec.ID, Name: rec.Name, Email: rec.Email})
 	}
+	return users, nil
 }
 
 // Calculator provides basic math operations.
@@ -76,6 +85,11 @@ type Calculator interface {
 	Add(a, b int) int
 	Subtract(a, b int) int
 	Multiply(a, b int) int
+
+	// Eval parses and evaluates an arithmetic expression such as
+	// "2 * (3 + 4)", reusing Add, Subtract, and Multiply for +, -, and *;
+	// / is integer division computed directly.
+	Eval(ctx context.Context, expr string) (int, error)
 }
 
 type basicCalculator struct{}
@@ -84,6 +98,13 @@ func (c *basicCalculator) Add(a, b int) int      { return a + b }
 func (c *basicCalculator) Subtract(a, b int) int { return a - b }
 func (c *basicCalculator) Multiply(a, b int) int { return a * b }
 
+func (c *basicCalculator) Eval(ctx context.Context, expr string) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	return calc.Eval(expr, c)
+}
+
 // NewCalculator creates a new Calculator.
 func NewCalculator() Calculator {
 	return &basicCalculator{}
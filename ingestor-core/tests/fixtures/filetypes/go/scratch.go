@@ -0,0 +1,135 @@
+package sample
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Scratch is a concurrency-safe scratchpad for transient, per-request
+// state, modeled on Hugo's maps.Scratch. Callers attach it to a request
+// to accumulate computed values (running counts, aggregated tags, and
+// the like) without needing their own locking.
+type Scratch struct {
+	mu     sync.RWMutex
+	values map[string]interface{}
+}
+
+// NewScratch returns an empty Scratch.
+func NewScratch() *Scratch {
+	return &Scratch{values: make(map[string]interface{})}
+}
+
+// NewScratch returns a Scratch for attaching transient computed state to
+// work done through s, without racing other callers of s.
+func (s *UserService) NewScratch() *Scratch {
+	return NewScratch()
+}
+
+// Set stores value under key, replacing any existing value.
+func (s *Scratch) Set(key string, value interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.values[key] = value
+}
+
+// Get returns the value stored under key, or nil if there is none.
+func (s *Scratch) Get(key string) interface{} {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.values[key]
+}
+
+// Delete removes key from the scratchpad.
+func (s *Scratch) Delete(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.values, key)
+}
+
+// Add adds value to the existing value stored under key, creating it if
+// absent. Ints and floats are summed, strings are concatenated, and
+// slices are appended to; mixing incompatible types returns an error.
+func (s *Scratch) Add(key string, value interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.values[key]
+	if !ok {
+		s.values[key] = value
+		return nil
+	}
+
+	sum, err := add(existing, value)
+	if err != nil {
+		return fmt.Errorf("scratch: add %q: %w", key, err)
+	}
+	s.values[key] = sum
+	return nil
+}
+
+// add combines a and b the way Scratch.Add does, without locking.
+func add(a, b interface{}) (interface{}, error) {
+	switch av := a.(type) {
+	case int:
+		bv, ok := b.(int)
+		if !ok {
+			return nil, fmt.Errorf("cannot add %T to int", b)
+		}
+		return av + bv, nil
+	case float64:
+		bv, ok := b.(float64)
+		if !ok {
+			return nil, fmt.Errorf("cannot add %T to float64", b)
+		}
+		return av + bv, nil
+	case string:
+		bv, ok := b.(string)
+		if !ok {
+			return nil, fmt.Errorf("cannot add %T to string", b)
+		}
+		return av + bv, nil
+	case []interface{}:
+		return append(append([]interface{}{}, av...), b), nil
+	default:
+		return nil, fmt.Errorf("cannot add to existing value of type %T", a)
+	}
+}
+
+// SetInMap stores value under key within the nested map stored at
+// mapKey, creating the nested map if it does not yet exist.
+func (s *Scratch) SetInMap(mapKey, key string, value interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	nested, ok := s.values[mapKey].(map[string]interface{})
+	if !ok {
+		nested = make(map[string]interface{})
+		s.values[mapKey] = nested
+	}
+	nested[key] = value
+}
+
+// GetSortedMapValues returns the values of the nested map stored at
+// mapKey, ordered by key for deterministic output.
+func (s *Scratch) GetSortedMapValues(mapKey string) []interface{} {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	nested, ok := s.values[mapKey].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	keys := make([]string, 0, len(nested))
+	for k := range nested {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	values := make([]interface{}, 0, len(keys))
+	for _, k := range keys {
+		values = append(values, nested[k])
+	}
+	return values
+}
@@ -0,0 +1,108 @@
+package sample
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestScratchAdd(t *testing.T) {
+	tests := []struct {
+		name    string
+		initial interface{}
+		add     interface{}
+		want    interface{}
+		wantErr bool
+	}{
+		{name: "ints sum", initial: 1, add: 2, want: 3},
+		{name: "floats sum", initial: 1.5, add: 2.5, want: 4.0},
+		{name: "strings concatenate", initial: "foo", add: "bar", want: "foobar"},
+		{name: "slice appends", initial: []interface{}{1}, add: 2, want: []interface{}{1, 2}},
+		{name: "absent key is set", initial: nil, add: 5, want: 5},
+		{name: "mismatched types error", initial: 1, add: "oops", wantErr: true},
+		{name: "slice added to int errors", initial: 1, add: []interface{}{2}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewScratch()
+			if tt.initial != nil {
+				s.Set("key", tt.initial)
+			}
+
+			err := s.Add("key", tt.add)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Add(%v, %v) = nil error, want error", tt.initial, tt.add)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Add(%v, %v) = %v, want nil", tt.initial, tt.add, err)
+			}
+
+			got := s.Get("key")
+			gotSlice, gotIsSlice := got.([]interface{})
+			wantSlice, wantIsSlice := tt.want.([]interface{})
+			if gotIsSlice && wantIsSlice {
+				if len(gotSlice) != len(wantSlice) {
+					t.Fatalf("Get(key) = %v, want %v", got, tt.want)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Fatalf("Get(key) = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScratchConcurrentSetAdd(t *testing.T) {
+	s := NewScratch()
+	var wg sync.WaitGroup
+
+	for i := 0; i < 100; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			s.Set("name-"+strconv.Itoa(i), i)
+		}(i)
+		go func() {
+			defer wg.Done()
+			_ = s.Add("count", 1)
+		}()
+	}
+	wg.Wait()
+
+	count, ok := s.Get("count").(int)
+	if !ok || count != 100 {
+		t.Fatalf("Get(count) = %v, want 100", s.Get("count"))
+	}
+}
+
+func TestScratchGetSortedMapValues(t *testing.T) {
+	s := NewScratch()
+	s.SetInMap("tags", "b", 2)
+	s.SetInMap("tags", "a", 1)
+	s.SetInMap("tags", "c", 3)
+
+	got := s.GetSortedMapValues("tags")
+	want := []interface{}{1, 2, 3}
+	if len(got) != len(want) {
+		t.Fatalf("GetSortedMapValues = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("GetSortedMapValues = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestUserServiceNewScratch(t *testing.T) {
+	svc := NewUserService()
+	scratch := svc.NewScratch()
+	scratch.Set("key", "value")
+	if got := scratch.Get("key"); got != "value" {
+		t.Fatalf("Get(key) = %v, want %q", got, "value")
+	}
+}
@@ -0,0 +1,150 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var usersBucket = []byte("users")
+
+// BoltStore is a UserStore backed by a BoltDB file, with each user
+// record JSON-encoded under its ID as the bucket key.
+type BoltStore struct {
+	db *bolt.DB
+}
+
+// NewBoltStore opens (creating if necessary) a BoltDB file at path and
+// returns a BoltStore backed by it.
+func NewBoltStore(path string) (*BoltStore, error) {
+	db, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		return nil, fmt.Errorf("store: open bolt db %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(usersBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("store: create users bucket: %w", err)
+	}
+
+	return &BoltStore{db: db}, nil
+}
+
+// Close releases the underlying BoltDB file handle.
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
+
+func userKey(id int) []byte {
+	return []byte(fmt.Sprintf("%010d", id))
+}
+
+// Add implements UserStore.
+func (s *BoltStore) Add(ctx context.Context, user *User) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(usersBucket)
+		if b.Get(userKey(user.ID)) != nil {
+			return &AlreadyExistsError{ID: user.ID}
+		}
+		data, err := json.Marshal(user)
+		if err != nil {
+			return err
+		}
+		return b.Put(userKey(user.ID), data)
+	})
+}
+
+// Get implements UserStore.
+func (s *BoltStore) Get(ctx context.Context, id int) (*User, bool, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, false, err
+	}
+
+	var user *User
+	err := s.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(usersBucket).Get(userKey(id))
+		if data == nil {
+			return nil
+		}
+		user = &User{}
+		return json.Unmarshal(data, user)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return user, user != nil, nil
+}
+
+// List implements UserStore.
+func (s *BoltStore) List(ctx context.Context, offset, limit int) ([]*User, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var users []*User
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(usersBucket).Cursor()
+		i := 0
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if i < offset {
+				i++
+				continue
+			}
+			if limit > 0 && len(users) >= limit {
+				break
+			}
+			user := &User{}
+			if err := json.Unmarshal(v, user); err != nil {
+				return err
+			}
+			users = append(users, user)
+			i++
+		}
+		return nil
+	})
+	return users, err
+}
+
+// Update implements UserStore.
+func (s *BoltStore) Update(ctx context.Context, user *User) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(usersBucket)
+		if b.Get(userKey(user.ID)) == nil {
+			return ErrNotFound
+		}
+		data, err := json.Marshal(user)
+		if err != nil {
+			return err
+		}
+		return b.Put(userKey(user.ID), data)
+	})
+}
+
+// Delete implements UserStore.
+func (s *BoltStore) Delete(ctx context.Context, id int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(usersBucket)
+		if b.Get(userKey(id)) == nil {
+			return ErrNotFound
+		}
+		return b.Delete(userKey(id))
+	})
+}
@@ -0,0 +1,20 @@
+package store_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"sample/store"
+	"sample/store/storetest"
+)
+
+func TestBoltStore(t *testing.T) {
+	storetest.Run(t, func() store.UserStore {
+		db, err := store.NewBoltStore(filepath.Join(t.TempDir(), "users.db"))
+		if err != nil {
+			t.Fatalf("NewBoltStore: %v", err)
+		}
+		t.Cleanup(func() { db.Close() })
+		return db
+	})
+}
@@ -0,0 +1,118 @@
+package store
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+// InMemoryStore is a UserStore backed by a map guarded with a
+// sync.RWMutex. It is the default backend sample.NewUserService uses.
+type InMemoryStore struct {
+	mu    sync.RWMutex
+	users map[int]*User
+}
+
+// NewInMemoryStore returns an empty InMemoryStore.
+func NewInMemoryStore() *InMemoryStore {
+	return &InMemoryStore{users: make(map[int]*User)}
+}
+
+// Add implements UserStore.
+func (s *InMemoryStore) Add(ctx context.Context, user *User) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.users[user.ID]; exists {
+		return &AlreadyExistsError{ID: user.ID}
+	}
+
+	cp := *user
+	s.users[user.ID] = &cp
+	return nil
+}
+
+// Get implements UserStore.
+func (s *InMemoryStore) Get(ctx context.Context, id int) (*User, bool, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, false, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	user, ok := s.users[id]
+	if !ok {
+		return nil, false, nil
+	}
+	cp := *user
+	return &cp, true, nil
+}
+
+// List implements UserStore.
+func (s *InMemoryStore) List(ctx context.Context, offset, limit int) ([]*User, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ids := make([]int, 0, len(s.users))
+	for id := range s.users {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	if offset > len(ids) {
+		offset = len(ids)
+	}
+	ids = ids[offset:]
+	if limit > 0 && limit < len(ids) {
+		ids = ids[:limit]
+	}
+
+	users := make([]*User, 0, len(ids))
+	for _, id := range ids {
+		cp := *s.users[id]
+		users = append(users, &cp)
+	}
+	return users, nil
+}
+
+// Update implements UserStore.
+func (s *InMemoryStore) Update(ctx context.Context, user *User) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.users[user.ID]; !exists {
+		return ErrNotFound
+	}
+	cp := *user
+	s.users[user.ID] = &cp
+	return nil
+}
+
+// Delete implements UserStore.
+func (s *InMemoryStore) Delete(ctx context.Context, id int) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.users[id]; !exists {
+		return ErrNotFound
+	}
+	delete(s.users, id)
+	return nil
+}
@@ -0,0 +1,14 @@
+package store_test
+
+import (
+	"testing"
+
+	"sample/store"
+	"sample/store/storetest"
+)
+
+func TestInMemoryStore(t *testing.T) {
+	storetest.Run(t, func() store.UserStore {
+		return store.NewInMemoryStore()
+	})
+}
@@ -0,0 +1,115 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// SQLStore is a UserStore backed by a *sql.DB, using a single "users"
+// table with columns (id INTEGER PRIMARY KEY, name TEXT, email TEXT).
+// Callers are responsible for opening db against whichever driver and
+// schema migration they prefer.
+type SQLStore struct {
+	db *sql.DB
+}
+
+// NewSQLStore returns a SQLStore backed by db.
+func NewSQLStore(db *sql.DB) *SQLStore {
+	return &SQLStore{db: db}
+}
+
+// Add implements UserStore.
+func (s *SQLStore) Add(ctx context.Context, user *User) error {
+	_, err := s.db.ExecContext(ctx, `INSERT INTO users (id, name, email) VALUES (?, ?, ?)`, user.ID, user.Name, user.Email)
+	if isUniqueViolation(err) {
+		return &AlreadyExistsError{ID: user.ID}
+	}
+	return err
+}
+
+// Get implements UserStore.
+func (s *SQLStore) Get(ctx context.Context, id int) (*User, bool, error) {
+	row := s.db.QueryRowContext(ctx, `SELECT id, name, email FROM users WHERE id = ?`, id)
+
+	user := &User{}
+	if err := row.Scan(&user.ID, &user.Name, &user.Email); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	return user, true, nil
+}
+
+// List implements UserStore.
+func (s *SQLStore) List(ctx context.Context, offset, limit int) ([]*User, error) {
+	query := `SELECT id, name, email FROM users ORDER BY id LIMIT ? OFFSET ?`
+	if limit <= 0 {
+		limit = -1 // unlimited in SQLite's LIMIT semantics
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("store: list users: %w", err)
+	}
+	defer rows.Close()
+
+	var users []*User
+	for rows.Next() {
+		user := &User{}
+		if err := rows.Scan(&user.ID, &user.Name, &user.Email); err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	return users, rows.Err()
+}
+
+// Update implements UserStore.
+func (s *SQLStore) Update(ctx context.Context, user *User) error {
+	result, err := s.db.ExecContext(ctx, `UPDATE users SET name = ?, email = ? WHERE id = ?`, user.Name, user.Email, user.ID)
+	if err != nil {
+		return err
+	}
+	return requireRowAffected(result)
+}
+
+// Delete implements UserStore.
+func (s *SQLStore) Delete(ctx context.Context, id int) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM users WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+	return requireRowAffected(result)
+}
+
+func requireRowAffected(result sql.Result) error {
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// isUniqueViolation reports whether err looks like a primary-key or
+// unique-constraint violation. Driver error types vary, so this checks
+// for the substring common SQL drivers include rather than depending on
+// any one driver package.
+func isUniqueViolation(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	for _, sub := range []string{"UNIQUE constraint failed", "duplicate key", "Duplicate entry"} {
+		if strings.Contains(msg, sub) {
+			return true
+		}
+	}
+	return false
+}
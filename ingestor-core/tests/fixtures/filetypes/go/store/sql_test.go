@@ -0,0 +1,49 @@
+package store_test
+
+import (
+	"database/sql"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	_ "modernc.org/sqlite"
+
+	"sample/store"
+	"sample/store/storetest"
+)
+
+const schema = `CREATE TABLE users (
+	id    INTEGER PRIMARY KEY,
+	name  TEXT NOT NULL,
+	email TEXT NOT NULL
+)`
+
+// dbSeq gives each factory call its own named in-memory database. A
+// shared-cache in-memory database is keyed by name process-wide, not
+// per *sql.DB, so reusing "file::memory:" across the suite's separate
+// factory() calls would let one subtest see another's tables and rows.
+var dbSeq int32
+
+func TestSQLStore(t *testing.T) {
+	storetest.Run(t, func() store.UserStore {
+		name := fmt.Sprintf("file:sqlstore%d?mode=memory&cache=shared", atomic.AddInt32(&dbSeq, 1))
+
+		// cache=shared keeps every pooled connection pointing at the same
+		// named in-memory database; plain ":memory:" gives each pooled
+		// connection its own private database, so a second connection
+		// opened under concurrent load would see "no such table". Capping
+		// the pool at one connection also serializes sqlite's writers,
+		// which don't support true concurrent writes.
+		db, err := sql.Open("sqlite", name)
+		if err != nil {
+			t.Fatalf("sql.Open: %v", err)
+		}
+		db.SetMaxOpenConns(1)
+		t.Cleanup(func() { db.Close() })
+
+		if _, err := db.Exec(schema); err != nil {
+			t.Fatalf("create schema: %v", err)
+		}
+		return store.NewSQLStore(db)
+	})
+}
@@ -0,0 +1,52 @@
+// Package store defines the persistence layer behind sample.UserService,
+// so the business logic in sample can pick a backend (in-memory, Bolt,
+// SQL) without changing how it adds, looks up, or lists users.
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// User is the persisted shape of a user record. It is independent of
+// sample.User so this package does not import sample.
+type User struct {
+	ID    int
+	Name  string
+	Email string
+}
+
+// ErrNotFound is returned by Update and Delete when no record exists for
+// the given ID. Get reports a missing record with ok=false instead.
+var ErrNotFound = errors.New("store: user not found")
+
+// AlreadyExistsError is returned by Add when a record with the same ID
+// is already stored.
+type AlreadyExistsError struct {
+	ID int
+}
+
+func (e *AlreadyExistsError) Error() string {
+	return fmt.Sprintf("store: user %d already exists", e.ID)
+}
+
+// UserStore is the persistence interface sample.UserService is built
+// on. Every method takes a context so a backend can honor cancellation
+// and deadlines for calls that cross a network or disk boundary.
+type UserStore interface {
+	// Add stores user, returning *AlreadyExistsError if its ID is taken.
+	Add(ctx context.Context, user *User) error
+	// Get returns the user with the given ID, or ok=false if there is
+	// none.
+	Get(ctx context.Context, id int) (user *User, ok bool, err error)
+	// List returns up to limit users ordered by ID, starting after the
+	// first offset. A limit of 0 means no limit.
+	List(ctx context.Context, offset, limit int) ([]*User, error)
+	// Update replaces the stored record for user.ID, returning
+	// ErrNotFound if it does not exist.
+	Update(ctx context.Context, user *User) error
+	// Delete removes the user with the given ID, returning ErrNotFound
+	// if it does not exist.
+	Delete(ctx context.Context, id int) error
+}
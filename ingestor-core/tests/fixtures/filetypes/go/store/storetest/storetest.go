@@ -0,0 +1,143 @@
+// Package storetest is a conformance suite every store.UserStore backend
+// runs against, so a new implementation only needs to plug itself in
+// here rather than reinvent these cases.
+package storetest
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"sample/store"
+)
+
+// Factory returns a fresh, empty store.UserStore for a single test to
+// use.
+type Factory func() store.UserStore
+
+// Run exercises factory's store against every case in the suite.
+func Run(t *testing.T, factory Factory) {
+	t.Helper()
+
+	t.Run("AddAndGet", func(t *testing.T) { testAddAndGet(t, factory) })
+	t.Run("DuplicateAddFails", func(t *testing.T) { testDuplicateAddFails(t, factory) })
+	t.Run("GetMissingReturnsNotOK", func(t *testing.T) { testGetMissingReturnsNotOK(t, factory) })
+	t.Run("UpdateMissingReturnsErrNotFound", func(t *testing.T) { testUpdateMissingReturnsErrNotFound(t, factory) })
+	t.Run("DeleteMissingReturnsErrNotFound", func(t *testing.T) { testDeleteMissingReturnsErrNotFound(t, factory) })
+	t.Run("ListPagination", func(t *testing.T) { testListPagination(t, factory) })
+	t.Run("ConcurrentAddGet", func(t *testing.T) { testConcurrentAddGet(t, factory) })
+}
+
+func testAddAndGet(t *testing.T, factory Factory) {
+	s := factory()
+	ctx := context.Background()
+
+	want := &store.User{ID: 1, Name: "Ada", Email: "ada@example.com"}
+	if err := s.Add(ctx, want); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	got, ok, err := s.Get(ctx, 1)
+	if err != nil || !ok {
+		t.Fatalf("Get = %+v, %v, %v", got, ok, err)
+	}
+	if *got != *want {
+		t.Fatalf("Get = %+v, want %+v", got, want)
+	}
+}
+
+func testDuplicateAddFails(t *testing.T, factory Factory) {
+	s := factory()
+	ctx := context.Background()
+
+	user := &store.User{ID: 1, Name: "Ada"}
+	if err := s.Add(ctx, user); err != nil {
+		t.Fatalf("first Add: %v", err)
+	}
+	if err := s.Add(ctx, user); err == nil {
+		t.Fatal("second Add: expected error, got nil")
+	}
+}
+
+func testGetMissingReturnsNotOK(t *testing.T, factory Factory) {
+	s := factory()
+	ctx := context.Background()
+
+	_, ok, err := s.Get(ctx, 404)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if ok {
+		t.Fatal("Get: ok = true for a user that was never added")
+	}
+}
+
+func testUpdateMissingReturnsErrNotFound(t *testing.T, factory Factory) {
+	s := factory()
+	ctx := context.Background()
+
+	err := s.Update(ctx, &store.User{ID: 404, Name: "Ghost"})
+	if err != store.ErrNotFound {
+		t.Fatalf("Update: err = %v, want store.ErrNotFound", err)
+	}
+}
+
+func testDeleteMissingReturnsErrNotFound(t *testing.T, factory Factory) {
+	s := factory()
+	ctx := context.Background()
+
+	if err := s.Delete(ctx, 404); err != store.ErrNotFound {
+		t.Fatalf("Delete: err = %v, want store.ErrNotFound", err)
+	}
+}
+
+func testListPagination(t *testing.T, factory Factory) {
+	s := factory()
+	ctx := context.Background()
+
+	for i := 1; i <= 5; i++ {
+		if err := s.Add(ctx, &store.User{ID: i, Name: "User"}); err != nil {
+			t.Fatalf("Add(%d): %v", i, err)
+		}
+	}
+
+	page, err := s.List(ctx, 1, 2)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(page) != 2 || page[0].ID != 2 || page[1].ID != 3 {
+		t.Fatalf("List(offset=1, limit=2) = %+v, want users 2 and 3", page)
+	}
+
+	all, err := s.List(ctx, 0, 0)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(all) != 5 {
+		t.Fatalf("List(offset=0, limit=0) returned %d users, want 5", len(all))
+	}
+}
+
+func testConcurrentAddGet(t *testing.T, factory Factory) {
+	s := factory()
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_ = s.Add(ctx, &store.User{ID: i, Name: "User"})
+			_, _, _ = s.Get(ctx, i)
+		}(i)
+	}
+	wg.Wait()
+
+	all, err := s.List(ctx, 0, 0)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(all) != 50 {
+		t.Fatalf("List returned %d users, want 50", len(all))
+	}
+}